@@ -0,0 +1,111 @@
+// Command arols-runner is the reference implementation of the Analyzer
+// gRPC service described in proto/analyzer.proto. It delegates to the same
+// Python analyzer the API server used to exec directly, just moved behind
+// a network boundary so it can be scaled and sandboxed independently.
+//
+// Run `make proto` before building this binary.
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+
+	"github.com/thesfb/AROLS/internal/analysis"
+	"github.com/thesfb/AROLS/internal/log"
+	"github.com/thesfb/AROLS/internal/runner"
+	pb "github.com/thesfb/AROLS/proto/analyzerpb"
+)
+
+func main() {
+	logger := log.New()
+
+	addr := os.Getenv("RUNNER_LISTEN_ADDR")
+	if addr == "" {
+		addr = ":9090"
+	}
+
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		logger.Errorf("listen on %s: %v", addr, err)
+		os.Exit(1)
+	}
+
+	srv := grpc.NewServer()
+	pb.RegisterAnalyzerServer(srv, &analyzerServer{local: runner.NewLocalRunner(logger), logger: logger})
+
+	logger.Infof("arols-runner listening on %s", addr)
+	if err := srv.Serve(lis); err != nil {
+		logger.Errorf("serve: %v", err)
+		os.Exit(1)
+	}
+}
+
+// analyzerServer adapts runner.LocalRunner (the same exec-python3 logic the
+// API server used in-process) to the Analyzer gRPC contract.
+type analyzerServer struct {
+	pb.UnimplementedAnalyzerServer
+	local  *runner.LocalRunner
+	logger *log.Logger
+}
+
+func (s *analyzerServer) Analyze(stream pb.Analyzer_AnalyzeServer) error {
+	chunk, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("arols-runner: recv first chunk: %w", err)
+	}
+
+	ref, ok := chunk.Payload.(*pb.Chunk_ArchiveRef)
+	if !ok {
+		return fmt.Errorf("arols-runner: expected an archive reference as the first chunk")
+	}
+
+	result, err := s.local.Analyze(stream.Context(), ref.ArchiveRef.ExtractPath, func(p runner.Progress) {
+		stream.Send(&pb.AnalyzeEvent{
+			Event: &pb.AnalyzeEvent_Progress{Progress: &pb.Progress{Percent: int32(p.Percent), Stage: p.Stage}},
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("arols-runner: analyze: %w", err)
+	}
+
+	return stream.Send(&pb.AnalyzeEvent{
+		Event: &pb.AnalyzeEvent_Result{Result: resultToProto(result)},
+	})
+}
+
+func resultToProto(r *analysis.Result) *pb.AnalysisResult {
+	out := &pb.AnalysisResult{
+		JobId:           r.JobID,
+		ProjectName:     r.ProjectName,
+		TotalFiles:      int32(r.TotalFiles),
+		TotalLines:      int32(r.TotalLines),
+		ComplexityScore: r.ComplexityScore,
+		Recommendations: r.Recommendations,
+		GeneratedAt:     r.GeneratedAt,
+		Languages:       make(map[string]int32, len(r.Languages)),
+	}
+
+	for lang, count := range r.Languages {
+		out.Languages[lang] = int32(count)
+	}
+	for _, s := range r.SecurityIssues {
+		out.SecurityIssues = append(out.SecurityIssues, &pb.SecurityIssue{
+			Type: s.Type, Severity: s.Severity, File: s.File, Line: int32(s.Line), Description: s.Description,
+		})
+	}
+	for _, s := range r.CodeSmells {
+		out.CodeSmells = append(out.CodeSmells, &pb.CodeSmell{
+			Type: s.Type, File: s.File, Line: int32(s.Line), Description: s.Description, Suggestion: s.Suggestion,
+		})
+	}
+	for _, b := range r.BusinessLogic {
+		out.BusinessLogic = append(out.BusinessLogic, &pb.BusinessLogicPattern{
+			Type: b.Type, File: b.File, Function: b.Function, Description: b.Description, Value: b.Value,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,42 @@
+// File: internal/analysis/types.go
+package analysis
+
+// Result is the analysis output produced by a runner.Runner, whether that's
+// the local Python analyzer or a remote gRPC analyzer service.
+type Result struct {
+	JobID           string                 `json:"job_id"`
+	ProjectName     string                 `json:"project_name"`
+	TotalFiles      int                    `json:"total_files"`
+	TotalLines      int                    `json:"total_lines"`
+	Languages       map[string]int         `json:"languages"`
+	ComplexityScore float64                `json:"complexity_score"`
+	SecurityIssues  []SecurityIssue        `json:"security_issues"`
+	CodeSmells      []CodeSmell            `json:"code_smells"`
+	BusinessLogic   []BusinessLogicPattern `json:"business_logic"`
+	Recommendations []string               `json:"recommendations"`
+	GeneratedAt     string                 `json:"generated_at"`
+}
+
+type SecurityIssue struct {
+	Type        string `json:"type"`
+	Severity    string `json:"severity"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	Description string `json:"description"`
+}
+
+type CodeSmell struct {
+	Type        string `json:"type"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	Description string `json:"description"`
+	Suggestion  string `json:"suggestion"`
+}
+
+type BusinessLogicPattern struct {
+	Type        string `json:"type"`
+	File        string `json:"file"`
+	Function    string `json:"function"`
+	Description string `json:"description"`
+	Value       string `json:"value"`
+}
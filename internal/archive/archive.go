@@ -0,0 +1,133 @@
+// File: internal/archive/archive.go
+package archive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrLimitExceeded is returned when an archive trips one of the configured
+// Limits, e.g. to guard against zip-bomb style decompression-ratio abuse.
+var ErrLimitExceeded = errors.New("archive: limit exceeded")
+
+// Limits bound how much an Extract call will do before bailing out, so a
+// hostile upload can't exhaust disk or memory.
+type Limits struct {
+	MaxFiles                  int   // maximum number of entries an archive may contain
+	MaxFileUncompressedBytes  int64 // maximum size of any single extracted file
+	MaxTotalUncompressedBytes int64 // maximum total size of all extracted files combined
+}
+
+// DefaultLimits are generous enough for a real codebase upload while still
+// bounding the worst case.
+func DefaultLimits() Limits {
+	return Limits{
+		MaxFiles:                  20_000,
+		MaxFileUncompressedBytes:  512 << 20, // 512 MiB
+		MaxTotalUncompressedBytes: 2 << 30,   // 2 GiB
+	}
+}
+
+// Format identifies an archive's container format, detected from its
+// content rather than trusted from a filename or Content-Type header.
+type Format int
+
+const (
+	FormatUnknown Format = iota
+	FormatZip
+	FormatTarGz
+	FormatTarZst
+)
+
+var (
+	zipMagic  = []byte{'P', 'K', 0x03, 0x04}
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstMagic  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// DetectFormat sniffs an archive's format from its leading bytes. header
+// should contain at least the first 4 bytes of the file; shorter input is
+// treated as FormatUnknown.
+func DetectFormat(header []byte) Format {
+	switch {
+	case hasPrefix(header, zipMagic):
+		return FormatZip
+	case hasPrefix(header, gzipMagic):
+		return FormatTarGz
+	case hasPrefix(header, zstMagic):
+		return FormatTarZst
+	default:
+		return FormatUnknown
+	}
+}
+
+func hasPrefix(b, prefix []byte) bool {
+	return len(b) >= len(prefix) && string(b[:len(prefix)]) == string(prefix)
+}
+
+// Extract detects srcPath's format from its content and extracts it into
+// destDir, which is created if needed. Every entry path is validated to
+// stay within destDir (no zip-slip), symlink entries are rejected outright,
+// and limits bound file count and total/per-file size.
+func Extract(srcPath, destDir string, limits Limits) error {
+	f, err := os.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("archive: open %s: %w", srcPath, err)
+	}
+	defer f.Close()
+
+	header := make([]byte, 4)
+	n, _ := f.Read(header)
+	format := DetectFormat(header[:n])
+
+	// extractTarGz/extractTarZst read from f starting wherever it's
+	// currently positioned, so rewind past the sniff above before handing
+	// it off; extractZip instead reopens srcPath itself via archive/zip.
+	if format == FormatTarGz || format == FormatTarZst {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("archive: seek %s: %w", srcPath, err)
+		}
+	}
+
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return fmt.Errorf("archive: create dest dir: %w", err)
+	}
+
+	switch format {
+	case FormatZip:
+		return extractZip(srcPath, destDir, limits)
+	case FormatTarGz:
+		return extractTarGz(f, destDir, limits)
+	case FormatTarZst:
+		return extractTarZst(f, destDir, limits)
+	default:
+		return fmt.Errorf("archive: %s is not a recognized zip, tar.gz, or tar.zst archive", srcPath)
+	}
+}
+
+// safeJoin resolves name against destDir and rejects anything that would
+// escape it (zip-slip / tar-slip: "../../etc/passwd", absolute paths, etc).
+func safeJoin(destDir, name string) (string, error) {
+	cleaned := filepath.Clean(strings.ReplaceAll(name, "\\", "/"))
+	if filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("archive: entry %q escapes the destination directory", name)
+	}
+
+	full := filepath.Join(destDir, cleaned)
+	rel, err := filepath.Rel(destDir, full)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("archive: entry %q escapes the destination directory", name)
+	}
+
+	return full, nil
+}
+
+// maskWorldWritable strips group/other write bits so an archive can't drop
+// a world-writable file into the extraction directory.
+func maskWorldWritable(mode os.FileMode) os.FileMode {
+	return mode &^ 0o022
+}
@@ -0,0 +1,330 @@
+// File: internal/archive/archive_test.go
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// writeZip builds a zip file on disk from the given entries and returns its
+// path. Entries with a non-zero mode (e.g. os.ModeSymlink) get that mode set
+// on the zip header so we can simulate malicious archives.
+func writeZip(t *testing.T, dir string, entries map[string]string, modes map[string]os.FileMode) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "test.zip")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create zip: %v", err)
+	}
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for name, content := range entries {
+		hdr := &zip.FileHeader{Name: name, Method: zip.Deflate}
+		if mode, ok := modes[name]; ok {
+			hdr.SetMode(mode)
+		} else {
+			hdr.SetMode(0644)
+		}
+
+		entryWriter, err := w.CreateHeader(hdr)
+		if err != nil {
+			t.Fatalf("create entry %s: %v", name, err)
+		}
+		if _, err := entryWriter.Write([]byte(content)); err != nil {
+			t.Fatalf("write entry %s: %v", name, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("close zip writer: %v", err)
+	}
+
+	return path
+}
+
+func TestExtractZipPathTraversal(t *testing.T) {
+	cases := []struct {
+		name  string
+		entry string
+	}{
+		{"relative escape", "../../etc/passwd"},
+		{"absolute path", "/etc/passwd"},
+		{"nested escape", "sub/../../escape.txt"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			src := dir
+			dest := filepath.Join(dir, "dest")
+
+			zipPath := writeZip(t, src, map[string]string{tc.entry: "malicious"}, nil)
+
+			err := Extract(zipPath, dest, DefaultLimits())
+			if err == nil {
+				t.Fatalf("expected path traversal entry %q to be rejected", tc.entry)
+			}
+		})
+	}
+}
+
+func TestExtractZipSymlinkRejected(t *testing.T) {
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "dest")
+
+	modes := map[string]os.FileMode{"evil-link": os.ModeSymlink | 0777}
+	zipPath := writeZip(t, dir, map[string]string{"evil-link": "/etc/passwd"}, modes)
+
+	if err := Extract(zipPath, dest, DefaultLimits()); err == nil {
+		t.Fatal("expected symlink entry to be rejected")
+	}
+}
+
+func TestExtractZipDecompressionRatioAbuse(t *testing.T) {
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "dest")
+
+	// A few KB of a single repeated byte compresses to almost nothing but
+	// expands well past a tight per-file limit - the shape of a zip bomb.
+	bomb := strings.Repeat("A", 64*1024)
+	zipPath := writeZip(t, dir, map[string]string{"bomb.txt": bomb}, nil)
+
+	tight := DefaultLimits()
+	tight.MaxFileUncompressedBytes = 1024
+	tight.MaxTotalUncompressedBytes = 1024
+
+	err := Extract(zipPath, dest, tight)
+	if err == nil {
+		t.Fatal("expected decompression-ratio limit to reject the entry")
+	}
+}
+
+func TestExtractZipHappyPath(t *testing.T) {
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "dest")
+
+	zipPath := writeZip(t, dir, map[string]string{
+		"main.go":       "package main",
+		"pkg/helper.go": "package pkg",
+	}, nil)
+
+	if err := Extract(zipPath, dest, DefaultLimits()); err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dest, "main.go"))
+	if err != nil {
+		t.Fatalf("read extracted file: %v", err)
+	}
+	if !bytes.Equal(got, []byte("package main")) {
+		t.Fatalf("unexpected content: %q", got)
+	}
+}
+
+// writeTarEntries writes entries to w as a tar stream. An entry whose mode
+// includes os.ModeSymlink is written as a symlink pointing at its content
+// string instead of a regular file, mirroring writeZip's modes convention.
+func writeTarEntries(t *testing.T, w io.Writer, entries map[string]string, modes map[string]os.FileMode) {
+	t.Helper()
+
+	tw := tar.NewWriter(w)
+	for name, content := range entries {
+		if modes[name]&os.ModeSymlink != 0 {
+			hdr := &tar.Header{Name: name, Typeflag: tar.TypeSymlink, Linkname: content, Mode: 0777}
+			if err := tw.WriteHeader(hdr); err != nil {
+				t.Fatalf("write symlink header %s: %v", name, err)
+			}
+			continue
+		}
+
+		mode := modes[name]
+		if mode == 0 {
+			mode = 0644
+		}
+		hdr := &tar.Header{Name: name, Mode: int64(mode.Perm()), Size: int64(len(content))}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatalf("write header %s: %v", name, err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatalf("write entry %s: %v", name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("close tar writer: %v", err)
+	}
+}
+
+// writeTarGz builds a .tar.gz file on disk from the given entries and
+// returns its path.
+func writeTarGz(t *testing.T, dir string, entries map[string]string, modes map[string]os.FileMode) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "test.tar.gz")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create tar.gz: %v", err)
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	writeTarEntries(t, gz, entries, modes)
+	if err := gz.Close(); err != nil {
+		t.Fatalf("close gzip writer: %v", err)
+	}
+
+	return path
+}
+
+// writeTarZst builds a .tar.zst file on disk from the given entries and
+// returns its path.
+func writeTarZst(t *testing.T, dir string, entries map[string]string, modes map[string]os.FileMode) string {
+	t.Helper()
+
+	path := filepath.Join(dir, "test.tar.zst")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create tar.zst: %v", err)
+	}
+	defer f.Close()
+
+	zw, err := zstd.NewWriter(f)
+	if err != nil {
+		t.Fatalf("create zstd writer: %v", err)
+	}
+	writeTarEntries(t, zw, entries, modes)
+	if err := zw.Close(); err != nil {
+		t.Fatalf("close zstd writer: %v", err)
+	}
+
+	return path
+}
+
+// tarBuilders covers both tar-based formats Extract supports, so the
+// traversal/symlink/ratio/happy-path cases below each run against real
+// .tar.gz and .tar.zst archives, not just the zip path.
+var tarBuilders = map[string]func(t *testing.T, dir string, entries map[string]string, modes map[string]os.FileMode) string{
+	"tar.gz":  writeTarGz,
+	"tar.zst": writeTarZst,
+}
+
+func TestExtractTarPathTraversal(t *testing.T) {
+	cases := []struct {
+		name  string
+		entry string
+	}{
+		{"relative escape", "../../etc/passwd"},
+		{"absolute path", "/etc/passwd"},
+		{"nested escape", "sub/../../escape.txt"},
+	}
+
+	for format, build := range tarBuilders {
+		for _, tc := range cases {
+			t.Run(format+"/"+tc.name, func(t *testing.T) {
+				dir := t.TempDir()
+				dest := filepath.Join(dir, "dest")
+
+				path := build(t, dir, map[string]string{tc.entry: "malicious"}, nil)
+
+				if err := Extract(path, dest, DefaultLimits()); err == nil {
+					t.Fatalf("expected path traversal entry %q to be rejected", tc.entry)
+				}
+			})
+		}
+	}
+}
+
+func TestExtractTarSymlinkRejected(t *testing.T) {
+	for format, build := range tarBuilders {
+		t.Run(format, func(t *testing.T) {
+			dir := t.TempDir()
+			dest := filepath.Join(dir, "dest")
+
+			modes := map[string]os.FileMode{"evil-link": os.ModeSymlink | 0777}
+			path := build(t, dir, map[string]string{"evil-link": "/etc/passwd"}, modes)
+
+			if err := Extract(path, dest, DefaultLimits()); err == nil {
+				t.Fatal("expected symlink entry to be rejected")
+			}
+		})
+	}
+}
+
+func TestExtractTarDecompressionRatioAbuse(t *testing.T) {
+	bomb := strings.Repeat("A", 64*1024)
+
+	tight := DefaultLimits()
+	tight.MaxFileUncompressedBytes = 1024
+	tight.MaxTotalUncompressedBytes = 1024
+
+	for format, build := range tarBuilders {
+		t.Run(format, func(t *testing.T) {
+			dir := t.TempDir()
+			dest := filepath.Join(dir, "dest")
+
+			path := build(t, dir, map[string]string{"bomb.txt": bomb}, nil)
+
+			if err := Extract(path, dest, tight); err == nil {
+				t.Fatal("expected decompression-ratio limit to reject the entry")
+			}
+		})
+	}
+}
+
+func TestExtractTarHappyPath(t *testing.T) {
+	for format, build := range tarBuilders {
+		t.Run(format, func(t *testing.T) {
+			dir := t.TempDir()
+			dest := filepath.Join(dir, "dest")
+
+			path := build(t, dir, map[string]string{
+				"main.go":       "package main",
+				"pkg/helper.go": "package pkg",
+			}, nil)
+
+			if err := Extract(path, dest, DefaultLimits()); err != nil {
+				t.Fatalf("Extract: %v", err)
+			}
+
+			got, err := os.ReadFile(filepath.Join(dest, "main.go"))
+			if err != nil {
+				t.Fatalf("read extracted file: %v", err)
+			}
+			if !bytes.Equal(got, []byte("package main")) {
+				t.Fatalf("unexpected content: %q", got)
+			}
+		})
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	cases := []struct {
+		name   string
+		header []byte
+		want   Format
+	}{
+		{"zip", []byte{'P', 'K', 0x03, 0x04}, FormatZip},
+		{"gzip", []byte{0x1f, 0x8b, 0x00, 0x00}, FormatTarGz},
+		{"zstd", []byte{0x28, 0xb5, 0x2f, 0xfd}, FormatTarZst},
+		{"unknown", []byte{0x00, 0x00, 0x00, 0x00}, FormatUnknown},
+		{"short", []byte{'P', 'K'}, FormatUnknown},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := DetectFormat(tc.header); got != tc.want {
+				t.Fatalf("DetectFormat(%v) = %v, want %v", tc.header, got, tc.want)
+			}
+		})
+	}
+}
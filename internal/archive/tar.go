@@ -0,0 +1,111 @@
+// File: internal/archive/tar.go
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+func extractTarGz(r io.Reader, destDir string, limits Limits) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("archive: open gzip stream: %w", err)
+	}
+	defer gz.Close()
+
+	return extractTar(tar.NewReader(gz), destDir, limits)
+}
+
+func extractTarZst(r io.Reader, destDir string, limits Limits) error {
+	zr, err := zstd.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("archive: open zstd stream: %w", err)
+	}
+	defer zr.Close()
+
+	return extractTar(tar.NewReader(zr), destDir, limits)
+}
+
+func extractTar(tr *tar.Reader, destDir string, limits Limits) error {
+	var fileCount int
+	var totalWritten int64
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("archive: read tar entry: %w", err)
+		}
+
+		fileCount++
+		if fileCount > limits.MaxFiles {
+			return fmt.Errorf("%w: more than %d entries", ErrLimitExceeded, limits.MaxFiles)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeSymlink, tar.TypeLink:
+			return fmt.Errorf("archive: symlink entries are not allowed: %s", header.Name)
+		}
+
+		destPath, err := safeJoin(destDir, header.Name)
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(destPath, 0755); err != nil {
+				return fmt.Errorf("archive: create dir %s: %w", destPath, err)
+			}
+			continue
+		case tar.TypeReg:
+			// handled below
+		default:
+			continue // skip devices, fifos, etc - nothing a codebase upload should contain
+		}
+
+		if header.Size > limits.MaxFileUncompressedBytes {
+			return fmt.Errorf("%w: %s is larger than the %d byte per-file limit", ErrLimitExceeded, header.Name, limits.MaxFileUncompressedBytes)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+			return fmt.Errorf("archive: create dir for %s: %w", destPath, err)
+		}
+
+		written, err := extractTarEntry(tr, destPath, header, limits.MaxFileUncompressedBytes)
+		if err != nil {
+			return err
+		}
+
+		totalWritten += written
+		if totalWritten > limits.MaxTotalUncompressedBytes {
+			return fmt.Errorf("%w: total extracted size exceeds %d bytes", ErrLimitExceeded, limits.MaxTotalUncompressedBytes)
+		}
+	}
+}
+
+func extractTarEntry(tr *tar.Reader, destPath string, header *tar.Header, maxBytes int64) (int64, error) {
+	out, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, maskWorldWritable(header.FileInfo().Mode()))
+	if err != nil {
+		return 0, fmt.Errorf("archive: create %s: %w", destPath, err)
+	}
+	defer out.Close()
+
+	written, err := io.CopyN(out, tr, maxBytes+1)
+	if err != nil && err != io.EOF {
+		return 0, fmt.Errorf("archive: write %s: %w", destPath, err)
+	}
+	if written > maxBytes {
+		return 0, fmt.Errorf("%w: %s exceeds the %d byte per-file limit", ErrLimitExceeded, header.Name, maxBytes)
+	}
+
+	return written, nil
+}
@@ -0,0 +1,90 @@
+// File: internal/archive/zip.go
+package archive
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+func extractZip(srcPath, destDir string, limits Limits) error {
+	r, err := zip.OpenReader(srcPath)
+	if err != nil {
+		return fmt.Errorf("archive: open zip: %w", err)
+	}
+	defer r.Close()
+
+	if len(r.File) > limits.MaxFiles {
+		return fmt.Errorf("%w: %d entries exceeds the %d file limit", ErrLimitExceeded, len(r.File), limits.MaxFiles)
+	}
+
+	var totalWritten int64
+
+	for _, entry := range r.File {
+		info := entry.FileInfo()
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("archive: symlink entries are not allowed: %s", entry.Name)
+		}
+
+		destPath, err := safeJoin(destDir, entry.Name)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if err := os.MkdirAll(destPath, 0755); err != nil {
+				return fmt.Errorf("archive: create dir %s: %w", destPath, err)
+			}
+			continue
+		}
+
+		if int64(entry.UncompressedSize64) > limits.MaxFileUncompressedBytes {
+			return fmt.Errorf("%w: %s is larger than the %d byte per-file limit", ErrLimitExceeded, entry.Name, limits.MaxFileUncompressedBytes)
+		}
+
+		if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+			return fmt.Errorf("archive: create dir for %s: %w", destPath, err)
+		}
+
+		written, err := extractZipEntry(entry, destPath, limits.MaxFileUncompressedBytes)
+		if err != nil {
+			return err
+		}
+
+		totalWritten += written
+		if totalWritten > limits.MaxTotalUncompressedBytes {
+			return fmt.Errorf("%w: total extracted size exceeds %d bytes", ErrLimitExceeded, limits.MaxTotalUncompressedBytes)
+		}
+	}
+
+	return nil
+}
+
+func extractZipEntry(entry *zip.File, destPath string, maxBytes int64) (int64, error) {
+	rc, err := entry.Open()
+	if err != nil {
+		return 0, fmt.Errorf("archive: open entry %s: %w", entry.Name, err)
+	}
+	defer rc.Close()
+
+	out, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, maskWorldWritable(entry.Mode()))
+	if err != nil {
+		return 0, fmt.Errorf("archive: create %s: %w", destPath, err)
+	}
+	defer out.Close()
+
+	// Copy one byte beyond the declared limit so we can tell a legitimate
+	// file ending exactly at the limit apart from one that overflows it.
+	written, err := io.CopyN(out, rc, maxBytes+1)
+	if err != nil && err != io.EOF {
+		return 0, fmt.Errorf("archive: write %s: %w", destPath, err)
+	}
+	if written > maxBytes {
+		return 0, fmt.Errorf("%w: %s exceeds the %d byte per-file limit", ErrLimitExceeded, entry.Name, maxBytes)
+	}
+
+	return written, nil
+}
@@ -0,0 +1,180 @@
+// File: internal/auth/auth.go
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/thesfb/AROLS/internal/user"
+)
+
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidCredentials is returned when a login's email/password don't match.
+	ErrInvalidCredentials = errors.New("auth: invalid credentials")
+	// ErrInvalidToken is returned when a token fails signature, expiry, or lookup checks.
+	ErrInvalidToken = errors.New("auth: invalid token")
+)
+
+// Claims are the custom fields carried by an access token, alongside the
+// standard registered claims (subject, expiry, ...).
+type Claims struct {
+	UserID int       `json:"user_id"`
+	Role   user.Role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// Service issues and verifies JWT access tokens and server-side refresh
+// tokens on top of internal/user.Store.
+type Service struct {
+	users  *user.Store
+	db     *pgxpool.Pool
+	secret []byte
+}
+
+// NewService creates an auth Service backed by the given user store and
+// signing secret.
+func NewService(users *user.Store, db *pgxpool.Pool, secret string) *Service {
+	return &Service{users: users, db: db, secret: []byte(secret)}
+}
+
+// Register creates a new user account.
+func (s *Service) Register(ctx context.Context, email, password string) (*user.User, error) {
+	return s.users.Create(ctx, email, password)
+}
+
+// Login verifies the given credentials and, on success, issues a fresh
+// access/refresh token pair. The refresh token is persisted (hashed) so it
+// can be looked up or revoked later.
+func (s *Service) Login(ctx context.Context, email, password string) (accessToken, refreshToken string, err error) {
+	u, err := s.users.Authenticate(ctx, email, password)
+	if err != nil {
+		return "", "", ErrInvalidCredentials
+	}
+
+	accessToken, err = s.newAccessToken(u)
+	if err != nil {
+		return "", "", fmt.Errorf("auth: sign access token: %w", err)
+	}
+
+	refreshToken, err = s.issueRefreshToken(ctx, u.ID)
+	if err != nil {
+		return "", "", fmt.Errorf("auth: issue refresh token: %w", err)
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// Refresh exchanges a valid, unrevoked refresh token for a new access token.
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (accessToken string, err error) {
+	userID, err := s.lookupRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+
+	accessToken, err = s.newAccessToken(u)
+	if err != nil {
+		return "", fmt.Errorf("auth: sign access token: %w", err)
+	}
+
+	return accessToken, nil
+}
+
+// Logout revokes a refresh token so it can no longer be exchanged.
+func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+	_, err := s.db.Exec(ctx,
+		`UPDATE refresh_tokens SET revoked = true WHERE token_hash = $1`,
+		hashToken(refreshToken))
+	return err
+}
+
+// ParseAccessToken validates an access token's signature and expiry and
+// returns its claims.
+func (s *Service) ParseAccessToken(tokenStr string) (*Claims, error) {
+	var claims Claims
+	token, err := jwt.ParseWithClaims(tokenStr, &claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return s.secret, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	return &claims, nil
+}
+
+func (s *Service) newAccessToken(u *user.User) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		UserID: u.ID,
+		Role:   u.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   u.Email,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(accessTokenTTL)),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(s.secret)
+}
+
+func (s *Service) issueRefreshToken(ctx context.Context, userID int) (string, error) {
+	raw, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.db.Exec(ctx,
+		`INSERT INTO refresh_tokens (token_hash, user_id, expires_at) VALUES ($1, $2, $3)`,
+		hashToken(raw), userID, time.Now().Add(refreshTokenTTL))
+	if err != nil {
+		return "", err
+	}
+
+	return raw, nil
+}
+
+func (s *Service) lookupRefreshToken(ctx context.Context, raw string) (userID int, err error) {
+	query := `SELECT user_id FROM refresh_tokens
+			  WHERE token_hash = $1 AND revoked = false AND expires_at > now()`
+
+	err = s.db.QueryRow(ctx, query, hashToken(raw)).Scan(&userID)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+
+	return userID, nil
+}
+
+func hashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
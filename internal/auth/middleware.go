@@ -0,0 +1,52 @@
+// File: internal/auth/middleware.go
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/thesfb/AROLS/internal/user"
+)
+
+const contextUserKey = "user"
+
+// RequireUser parses and validates the Authorization: Bearer <token> header,
+// loads the corresponding user, and stores it in the request context under
+// "user" for handlers to retrieve with CurrentUser.
+func (s *Service) RequireUser() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		parts := strings.SplitN(header, " ", 2)
+		if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing bearer token"})
+			return
+		}
+
+		claims, err := s.ParseAccessToken(parts[1])
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			return
+		}
+
+		u, err := s.users.GetByID(c.Request.Context(), claims.UserID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+			return
+		}
+
+		c.Set(contextUserKey, u)
+		c.Next()
+	}
+}
+
+// CurrentUser retrieves the authenticated user stored by RequireUser.
+func CurrentUser(c *gin.Context) (*user.User, bool) {
+	v, ok := c.Get(contextUserKey)
+	if !ok {
+		return nil, false
+	}
+	u, ok := v.(*user.User)
+	return u, ok
+}
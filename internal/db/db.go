@@ -0,0 +1,90 @@
+// File: internal/db/db.go
+package db
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"sort"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Connect opens a pooled connection to Postgres using the given DSN.
+func Connect(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("db: connect: %w", err)
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("db: ping: %w", err)
+	}
+
+	return pool, nil
+}
+
+// Migrate applies every *.sql file under migrations/ in filename order that
+// hasn't already been recorded in schema_migrations. It's intentionally
+// dumb (no down-migrations, no checksums) - good enough for this project's
+// size.
+func Migrate(ctx context.Context, pool *pgxpool.Pool) error {
+	if _, err := pool.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		name TEXT PRIMARY KEY,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`); err != nil {
+		return fmt.Errorf("db: create schema_migrations: %w", err)
+	}
+
+	entries, err := migrations.ReadDir("migrations")
+	if err != nil {
+		return fmt.Errorf("db: read migrations: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var applied bool
+		err := pool.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM schema_migrations WHERE name = $1)`, name).Scan(&applied)
+		if err != nil {
+			return fmt.Errorf("db: check migration %s: %w", name, err)
+		}
+		if applied {
+			continue
+		}
+
+		sqlBytes, err := migrations.ReadFile("migrations/" + name)
+		if err != nil {
+			return fmt.Errorf("db: read migration %s: %w", name, err)
+		}
+
+		tx, err := pool.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("db: begin migration %s: %w", name, err)
+		}
+
+		if _, err := tx.Exec(ctx, string(sqlBytes)); err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("db: apply migration %s: %w", name, err)
+		}
+
+		if _, err := tx.Exec(ctx, `INSERT INTO schema_migrations (name) VALUES ($1)`, name); err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("db: record migration %s: %w", name, err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("db: commit migration %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// File: internal/events/bus.go
+package events
+
+import "sync"
+
+// Event is a single update pushed to clients watching a job, serialized
+// as-is to SSE/WebSocket subscribers.
+type Event struct {
+	Type    string `json:"type"` // status, progress, log, result
+	Status  string `json:"status,omitempty"`
+	Percent int    `json:"percent,omitempty"`
+	Stage   string `json:"stage,omitempty"`
+	Line    string `json:"line,omitempty"`
+	JobID   string `json:"job_id,omitempty"`
+}
+
+// Bus fans out job events to subscribers, keyed by job ID. It has no
+// memory of events published before a subscriber joined; late joiners
+// rely on a prior GET /api/job/:id for current state.
+type Bus struct {
+	mu     sync.Mutex
+	topics map[string][]chan Event
+}
+
+// NewBus creates an empty Bus.
+func NewBus() *Bus {
+	return &Bus{topics: make(map[string][]chan Event)}
+}
+
+// Subscribe registers a new listener for jobID's events. The returned
+// channel is buffered so a slow consumer doesn't block Publish; callers
+// must invoke the returned unsubscribe func exactly once, typically on
+// client disconnect, to release it.
+func (b *Bus) Subscribe(jobID string) (<-chan Event, func()) {
+	ch := make(chan Event, 16)
+
+	b.mu.Lock()
+	b.topics[jobID] = append(b.topics[jobID], ch)
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			subs := b.topics[jobID]
+			for i, c := range subs {
+				if c == ch {
+					b.topics[jobID] = append(subs[:i], subs[i+1:]...)
+					break
+				}
+			}
+			if len(b.topics[jobID]) == 0 {
+				delete(b.topics, jobID)
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+// Publish delivers e to every current subscriber of jobID. Subscribers
+// that aren't keeping up have the event dropped rather than blocking the
+// publisher (runAnalysis), since events are a best-effort progress feed,
+// not a durable log.
+func (b *Bus) Publish(jobID string, e Event) {
+	b.mu.Lock()
+	subs := append([]chan Event(nil), b.topics[jobID]...)
+	b.mu.Unlock()
+
+	for _, ch := range subs {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// File: internal/job/job.go
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Job represents an analysis job persisted in Postgres.
+type Job struct {
+	ID              string     `json:"id"`
+	UserID          *int       `json:"user_id,omitempty"`
+	Status          string     `json:"status"` // pending, processing, completed, failed
+	UploadPath      string     `json:"upload_path"`
+	ResultPath      string     `json:"result_path,omitempty"`
+	ProgressPercent int        `json:"progress_percent"`
+	ProgressStage   string     `json:"progress_stage,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	CompletedAt     *time.Time `json:"completed_at,omitempty"`
+	Error           string     `json:"error,omitempty"`
+}
+
+const selectColumns = `id, user_id, status, upload_path, result_path, progress_percent, progress_stage, error, created_at, completed_at`
+
+// Store handles all database operations related to jobs.
+type Store struct {
+	db *pgxpool.Pool
+}
+
+// NewStore creates a new job Store.
+func NewStore(db *pgxpool.Pool) *Store {
+	return &Store{db: db}
+}
+
+// Create inserts a new pending job owned by userID (nil for unauthenticated
+// callers until auth is wired up).
+func (s *Store) Create(ctx context.Context, id string, userID *int, uploadPath string) (*Job, error) {
+	query := `INSERT INTO jobs (id, user_id, status, upload_path)
+			  VALUES ($1, $2, 'pending', $3)
+			  RETURNING ` + selectColumns
+
+	return s.scanRow(s.db.QueryRow(ctx, query, id, userID, uploadPath))
+}
+
+// Get retrieves a job by ID.
+func (s *Store) Get(ctx context.Context, id string) (*Job, error) {
+	query := `SELECT ` + selectColumns + ` FROM jobs WHERE id = $1`
+
+	j, err := s.scanRow(s.db.QueryRow(ctx, query, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return j, err
+}
+
+func (s *Store) scanRow(row pgx.Row) (*Job, error) {
+	var j Job
+	var resultPath, errMsg *string
+	err := row.Scan(&j.ID, &j.UserID, &j.Status, &j.UploadPath, &resultPath,
+		&j.ProgressPercent, &j.ProgressStage, &errMsg, &j.CreatedAt, &j.CompletedAt)
+	if err != nil {
+		return nil, err
+	}
+	if resultPath != nil {
+		j.ResultPath = *resultPath
+	}
+	if errMsg != nil {
+		j.Error = *errMsg
+	}
+	return &j, nil
+}
+
+// UpdateStatus sets a job's status (e.g. "processing").
+func (s *Store) UpdateStatus(ctx context.Context, id, status string) error {
+	_, err := s.db.Exec(ctx, `UPDATE jobs SET status = $1 WHERE id = $2`, status, id)
+	return err
+}
+
+// UpdateProgress records how far along an in-flight runner.Runner is, so
+// getJobStatus can report percent-complete without polling the runner.
+func (s *Store) UpdateProgress(ctx context.Context, id string, percent int, stage string) error {
+	_, err := s.db.Exec(ctx,
+		`UPDATE jobs SET progress_percent = $1, progress_stage = $2 WHERE id = $3`,
+		percent, stage, id)
+	return err
+}
+
+// Complete marks a job as completed with the given result path.
+func (s *Store) Complete(ctx context.Context, id, resultPath string) error {
+	_, err := s.db.Exec(ctx,
+		`UPDATE jobs SET status = 'completed', result_path = $1, progress_percent = 100, completed_at = now() WHERE id = $2`,
+		resultPath, id)
+	return err
+}
+
+// Fail marks a job as failed with the given error message.
+func (s *Store) Fail(ctx context.Context, id, errMsg string) error {
+	_, err := s.db.Exec(ctx,
+		`UPDATE jobs SET status = 'failed', error = $1, completed_at = now() WHERE id = $2`,
+		errMsg, id)
+	return err
+}
+
+// ListJobs returns a page of jobs owned by userID, most recent first. A nil
+// userID lists jobs with no owner.
+func (s *Store) ListJobs(ctx context.Context, userID *int, limit, offset int) ([]*Job, error) {
+	query := `SELECT ` + selectColumns + `
+			  FROM jobs WHERE user_id IS NOT DISTINCT FROM $1
+			  ORDER BY created_at DESC
+			  LIMIT $2 OFFSET $3`
+
+	return s.queryJobs(ctx, query, userID, limit, offset)
+}
+
+// ListAll returns a page of every job regardless of owner, most recent
+// first. Intended for admin users.
+func (s *Store) ListAll(ctx context.Context, limit, offset int) ([]*Job, error) {
+	query := `SELECT ` + selectColumns + `
+			  FROM jobs
+			  ORDER BY created_at DESC
+			  LIMIT $1 OFFSET $2`
+
+	return s.queryJobs(ctx, query, limit, offset)
+}
+
+func (s *Store) queryJobs(ctx context.Context, query string, args ...interface{}) ([]*Job, error) {
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var jobs []*Job
+	for rows.Next() {
+		j, err := s.scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+
+	return jobs, rows.Err()
+}
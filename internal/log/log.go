@@ -0,0 +1,98 @@
+// File: internal/log/log.go
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+type ctxKey int
+
+const (
+	requestIDKey ctxKey = iota
+	jobIDKey
+)
+
+// Logger wraps slog.Logger with printf-style convenience methods and
+// request/job correlation via With(ctx).
+type Logger struct {
+	*slog.Logger
+}
+
+// New builds the process-wide Logger from LOG_FORMAT (text|json, default
+// text) and LOG_LEVEL (debug|info|warn|error, default info).
+func New() *Logger {
+	level := parseLevel(os.Getenv("LOG_LEVEL"))
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	if strings.EqualFold(os.Getenv("LOG_FORMAT"), "json") {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	} else {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	}
+
+	return &Logger{Logger: slog.New(handler)}
+}
+
+func parseLevel(raw string) slog.Level {
+	switch strings.ToLower(raw) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// With returns a Logger that tags every line with the request_id and
+// job_id carried on ctx, if any.
+func (l *Logger) With(ctx context.Context) *Logger {
+	attrs := make([]any, 0, 4)
+	if id, ok := RequestIDFromContext(ctx); ok {
+		attrs = append(attrs, "request_id", id)
+	}
+	if id, ok := JobIDFromContext(ctx); ok {
+		attrs = append(attrs, "job_id", id)
+	}
+	if len(attrs) == 0 {
+		return l
+	}
+	return &Logger{Logger: l.Logger.With(attrs...)}
+}
+
+func (l *Logger) Debugf(format string, args ...any) { l.Logger.Debug(fmt.Sprintf(format, args...)) }
+func (l *Logger) Infof(format string, args ...any)  { l.Logger.Info(fmt.Sprintf(format, args...)) }
+func (l *Logger) Warnf(format string, args ...any)  { l.Logger.Warn(fmt.Sprintf(format, args...)) }
+func (l *Logger) Errorf(format string, args ...any) { l.Logger.Error(fmt.Sprintf(format, args...)) }
+
+// ContextWithRequestID attaches a request ID to ctx for later retrieval by
+// With.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// ContextWithJobID attaches a job ID to ctx for later retrieval by With.
+func ContextWithJobID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, jobIDKey, id)
+}
+
+// RequestIDFromContext retrieves the request ID attached by
+// ContextWithRequestID, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey).(string)
+	return id, ok
+}
+
+// JobIDFromContext retrieves the job ID attached by ContextWithJobID, if
+// any.
+func JobIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(jobIDKey).(string)
+	return id, ok
+}
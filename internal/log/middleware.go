@@ -0,0 +1,21 @@
+// File: internal/log/middleware.go
+package log
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// RequestID generates a UUID per request, echoes it back as X-Request-ID,
+// and stores it on the request context so With(ctx) can pick it up
+// anywhere downstream.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := uuid.New().String()
+		c.Header(requestIDHeader, id)
+		c.Request = c.Request.WithContext(ContextWithRequestID(c.Request.Context(), id))
+		c.Next()
+	}
+}
@@ -0,0 +1,115 @@
+// File: internal/runner/grpc.go
+package runner
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/thesfb/AROLS/internal/analysis"
+	"github.com/thesfb/AROLS/internal/log"
+	pb "github.com/thesfb/AROLS/proto/analyzerpb"
+)
+
+// GRPCRunner dispatches analysis to a remote runner service (see
+// cmd/arols-runner) over the Analyzer gRPC contract in proto/analyzer.proto.
+// Run `make proto` to generate proto/analyzerpb before building with this
+// runner.
+type GRPCRunner struct {
+	conn   *grpc.ClientConn
+	client pb.AnalyzerClient
+	logger *log.Logger
+}
+
+// NewGRPCRunner dials the analyzer service at addr.
+func NewGRPCRunner(addr string, logger *log.Logger) (*GRPCRunner, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("runner: dial %s: %w", addr, err)
+	}
+
+	return &GRPCRunner{conn: conn, client: pb.NewAnalyzerClient(conn), logger: logger}, nil
+}
+
+// Close releases the underlying gRPC connection.
+func (r *GRPCRunner) Close() error {
+	return r.conn.Close()
+}
+
+func (r *GRPCRunner) Analyze(ctx context.Context, extractPath string, onProgress func(Progress)) (*analysis.Result, error) {
+	logger := r.logger.With(ctx)
+
+	stream, err := r.client.Analyze(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("runner: open stream: %w", err)
+	}
+
+	// The extracted tree already lives on shared storage reachable by the
+	// runner, so we send a reference rather than re-streaming every file.
+	if err := stream.Send(&pb.Chunk{
+		Payload: &pb.Chunk_ArchiveRef{ArchiveRef: &pb.ArchiveRef{ExtractPath: extractPath}},
+	}); err != nil {
+		return nil, fmt.Errorf("runner: send archive ref: %w", err)
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, fmt.Errorf("runner: close send: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil, fmt.Errorf("runner: stream closed without a result")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("runner: recv: %w", err)
+		}
+
+		switch e := event.Event.(type) {
+		case *pb.AnalyzeEvent_Progress:
+			logger.Debugf("remote analysis %d%% (%s)", e.Progress.Percent, e.Progress.Stage)
+			if onProgress != nil {
+				onProgress(Progress{Percent: int(e.Progress.Percent), Stage: e.Progress.Stage})
+			}
+		case *pb.AnalyzeEvent_Result:
+			logger.Infof("remote analysis complete: %d files, %d lines", e.Result.TotalFiles, e.Result.TotalLines)
+			return resultFromProto(e.Result), nil
+		}
+	}
+}
+
+func resultFromProto(r *pb.AnalysisResult) *analysis.Result {
+	out := &analysis.Result{
+		JobID:           r.JobId,
+		ProjectName:     r.ProjectName,
+		TotalFiles:      int(r.TotalFiles),
+		TotalLines:      int(r.TotalLines),
+		ComplexityScore: r.ComplexityScore,
+		Recommendations: r.Recommendations,
+		GeneratedAt:     r.GeneratedAt,
+		Languages:       make(map[string]int, len(r.Languages)),
+	}
+
+	for lang, count := range r.Languages {
+		out.Languages[lang] = int(count)
+	}
+	for _, s := range r.SecurityIssues {
+		out.SecurityIssues = append(out.SecurityIssues, analysis.SecurityIssue{
+			Type: s.Type, Severity: s.Severity, File: s.File, Line: int(s.Line), Description: s.Description,
+		})
+	}
+	for _, s := range r.CodeSmells {
+		out.CodeSmells = append(out.CodeSmells, analysis.CodeSmell{
+			Type: s.Type, File: s.File, Line: int(s.Line), Description: s.Description, Suggestion: s.Suggestion,
+		})
+	}
+	for _, b := range r.BusinessLogic {
+		out.BusinessLogic = append(out.BusinessLogic, analysis.BusinessLogicPattern{
+			Type: b.Type, File: b.File, Function: b.Function, Description: b.Description, Value: b.Value,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,65 @@
+// File: internal/runner/local.go
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/thesfb/AROLS/internal/analysis"
+	"github.com/thesfb/AROLS/internal/log"
+)
+
+// LocalRunner shells out to the Python analyzer in-process, exactly like
+// runAnalysis did before the runner abstraction existed.
+type LocalRunner struct {
+	logger *log.Logger
+}
+
+// NewLocalRunner creates a LocalRunner that logs through logger, tagged
+// with whatever request_id/job_id is on the context it's called with.
+func NewLocalRunner(logger *log.Logger) *LocalRunner {
+	return &LocalRunner{logger: logger}
+}
+
+func (r *LocalRunner) Analyze(ctx context.Context, extractPath string, onProgress func(Progress)) (*analysis.Result, error) {
+	logger := r.logger.With(ctx)
+	report(onProgress, Progress{Percent: 10, Stage: "analyze"})
+
+	resultPath := filepath.Join(filepath.Dir(extractPath), filepath.Base(extractPath)+".result.json")
+	defer os.Remove(resultPath)
+
+	logger.Infof("invoking python analyzer on %s", extractPath)
+	cmd := exec.CommandContext(ctx, "python3", "analyzer.py", extractPath, resultPath)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		logger.Errorf("python analyzer failed: %v (output: %s)", err, string(output))
+		return nil, fmt.Errorf("runner: python analyzer failed: %w (output: %s)", err, string(output))
+	}
+
+	report(onProgress, Progress{Percent: 90, Stage: "finalize"})
+
+	data, err := os.ReadFile(resultPath)
+	if err != nil {
+		return nil, fmt.Errorf("runner: read result: %w", err)
+	}
+
+	var result analysis.Result
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("runner: parse result: %w", err)
+	}
+
+	report(onProgress, Progress{Percent: 100, Stage: "finalize"})
+	logger.Infof("analysis complete: %d files, %d lines", result.TotalFiles, result.TotalLines)
+
+	return &result, nil
+}
+
+func report(onProgress func(Progress), p Progress) {
+	if onProgress != nil {
+		onProgress(p)
+	}
+}
@@ -0,0 +1,50 @@
+// File: internal/runner/runner.go
+package runner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thesfb/AROLS/internal/analysis"
+	"github.com/thesfb/AROLS/internal/log"
+)
+
+// Progress is a single stage update emitted while a Runner works through an
+// extracted codebase.
+type Progress struct {
+	Percent int    `json:"percent"`
+	Stage   string `json:"stage"` // extract, analyze, finalize
+}
+
+// Runner analyzes an already-extracted codebase and produces a Result.
+// onProgress may be nil; implementations must tolerate that.
+type Runner interface {
+	Analyze(ctx context.Context, extractPath string, onProgress func(Progress)) (*analysis.Result, error)
+}
+
+// Mode selects which Runner implementation to use.
+type Mode string
+
+const (
+	ModeLocal Mode = "local"
+	ModeGRPC  Mode = "grpc"
+)
+
+// Config configures runner construction; it's populated from
+// RUNNER_MODE/RUNNER_ADDR environment variables in main.go.
+type Config struct {
+	Mode Mode
+	Addr string // only used when Mode == ModeGRPC
+}
+
+// New builds the Runner selected by cfg.Mode.
+func New(cfg Config, logger *log.Logger) (Runner, error) {
+	switch cfg.Mode {
+	case "", ModeLocal:
+		return NewLocalRunner(logger), nil
+	case ModeGRPC:
+		return NewGRPCRunner(cfg.Addr, logger)
+	default:
+		return nil, fmt.Errorf("runner: unknown mode %q", cfg.Mode)
+	}
+}
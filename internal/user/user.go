@@ -9,11 +9,21 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Role identifies what a user is allowed to do. Most users are RoleUser;
+// RoleAdmin can see every job, not just their own.
+type Role string
+
+const (
+	RoleUser  Role = "user"
+	RoleAdmin Role = "admin"
+)
+
 // User defines the structure for a user in our application.
 type User struct {
 	ID           int       `json:"id"`
 	Email        string    `json:"email"`
 	PasswordHash string    `json:"-"` // The '-' tag prevents this from ever being sent in JSON responses
+	Role         Role      `json:"role"`
 	CreatedAt    time.Time `json:"created_at"`
 }
 
@@ -39,11 +49,11 @@ func (s *Store) Create(ctx context.Context, email, password string) (*User, erro
 	// The SQL query to insert a new user.
 	// We use RETURNING to get the new user's data back from the database in one query.
 	query := `INSERT INTO users (email, password_hash) VALUES ($1, $2)
-			  RETURNING id, email, password_hash, created_at`
+			  RETURNING id, email, password_hash, role, created_at`
 
 	var u User
 	// Execute the query.
-	err = s.db.QueryRow(ctx, query, email, string(hashedPassword)).Scan(&u.ID, &u.Email, &u.PasswordHash, &u.CreatedAt)
+	err = s.db.QueryRow(ctx, query, email, string(hashedPassword)).Scan(&u.ID, &u.Email, &u.PasswordHash, &u.Role, &u.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
@@ -54,10 +64,10 @@ func (s *Store) Create(ctx context.Context, email, password string) (*User, erro
 // GetByEmail retrieves a user by their email address.
 // This will be used during the login process.
 func (s *Store) GetByEmail(ctx context.Context, email string) (*User, error) {
-	query := `SELECT id, email, password_hash, created_at FROM users WHERE email = $1`
+	query := `SELECT id, email, password_hash, role, created_at FROM users WHERE email = $1`
 
 	var u User
-	err := s.db.QueryRow(ctx, query, email).Scan(&u.ID, &u.Email, &u.PasswordHash, &u.CreatedAt)
+	err := s.db.QueryRow(ctx, query, email).Scan(&u.ID, &u.Email, &u.PasswordHash, &u.Role, &u.CreatedAt)
 	if err != nil {
 		// It's common for a user not to be found, so we can handle this error specifically.
 		return nil, err
@@ -66,6 +76,20 @@ func (s *Store) GetByEmail(ctx context.Context, email string) (*User, error) {
 	return &u, nil
 }
 
+// GetByID retrieves a user by their primary key. Used by the auth
+// middleware to load the authenticated user for each request.
+func (s *Store) GetByID(ctx context.Context, id int) (*User, error) {
+	query := `SELECT id, email, password_hash, role, created_at FROM users WHERE id = $1`
+
+	var u User
+	err := s.db.QueryRow(ctx, query, id).Scan(&u.ID, &u.Email, &u.PasswordHash, &u.Role, &u.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
 // Authenticate checks if a given password matches the stored hash for a user.
 func (s *Store) Authenticate(ctx context.Context, email, password string) (*User, error) {
 	// First, get the user by their email.